@@ -0,0 +1,289 @@
+package gocloak
+
+import (
+	"context"
+)
+
+// PolicyEnforcementMode is the enforcement mode of a resource server
+type PolicyEnforcementMode string
+
+// PolicyEnforcementMode values supported by Keycloak
+const (
+	PolicyEnforcementModeEnforcing  PolicyEnforcementMode = "ENFORCING"
+	PolicyEnforcementModePermissive PolicyEnforcementMode = "PERMISSIVE"
+	PolicyEnforcementModeDisabled   PolicyEnforcementMode = "DISABLED"
+)
+
+// DecisionStrategy is the strategy used to reach a decision when several policies are in play
+type DecisionStrategy string
+
+// DecisionStrategy values supported by Keycloak
+const (
+	DecisionStrategyAffirmative DecisionStrategy = "AFFIRMATIVE"
+	DecisionStrategyUnanimous   DecisionStrategy = "UNANIMOUS"
+	DecisionStrategyConsensus   DecisionStrategy = "CONSENSUS"
+)
+
+// ResourceOwnerRepresentation identifies the owner of a resource
+type ResourceOwnerRepresentation struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// ResourceRepresentation is a resource protected by a client's authorization resource server
+type ResourceRepresentation struct {
+	ID                 string                       `json:"_id,omitempty"`
+	Name               string                       `json:"name,omitempty"`
+	DisplayName        string                       `json:"displayName,omitempty"`
+	Type               string                       `json:"type,omitempty"`
+	IconURI            string                       `json:"icon_uri,omitempty"`
+	URIs               []string                     `json:"uris,omitempty"`
+	Scopes             []ScopeRepresentation        `json:"scopes,omitempty"`
+	Attributes         map[string][]string          `json:"attributes,omitempty"`
+	Owner              *ResourceOwnerRepresentation `json:"owner,omitempty"`
+	OwnerManagedAccess bool                         `json:"ownerManagedAccess,omitempty"`
+}
+
+// ScopeRepresentation is a scope exposed by a client's authorization resource server
+type ScopeRepresentation struct {
+	ID          string                   `json:"id,omitempty"`
+	Name        string                   `json:"name,omitempty"`
+	DisplayName string                   `json:"displayName,omitempty"`
+	IconURI     string                   `json:"iconUri,omitempty"`
+	Policies    []PolicyRepresentation   `json:"policies,omitempty"`
+	Resources   []ResourceRepresentation `json:"resources,omitempty"`
+}
+
+// PolicyRepresentation is an authorization policy.
+// Type selects the subtype (role, user, group, js, time, aggregate, client, ...);
+// subtype-specific settings (e.g. "roles", "users", "code") live in Config as
+// Keycloak serializes them, matching the ComponentConfig/ProtocolMappersConfig pattern.
+type PolicyRepresentation struct {
+	ID               string            `json:"id,omitempty"`
+	Name             string            `json:"name,omitempty"`
+	Description      string            `json:"description,omitempty"`
+	Type             string            `json:"type,omitempty"`
+	Logic            string            `json:"logic,omitempty"`
+	DecisionStrategy DecisionStrategy  `json:"decisionStrategy,omitempty"`
+	Config           map[string]string `json:"config,omitempty"`
+	Policies         []string          `json:"policies,omitempty"`
+	Resources        []string          `json:"resources,omitempty"`
+	Scopes           []string          `json:"scopes,omitempty"`
+}
+
+// PermissionRepresentation is a resource-based or scope-based permission
+type PermissionRepresentation struct {
+	ID               string           `json:"id,omitempty"`
+	Name             string           `json:"name,omitempty"`
+	Description      string           `json:"description,omitempty"`
+	Type             string           `json:"type,omitempty"`
+	Logic            string           `json:"logic,omitempty"`
+	DecisionStrategy DecisionStrategy `json:"decisionStrategy,omitempty"`
+	Resources        []string         `json:"resources,omitempty"`
+	Scopes           []string         `json:"scopes,omitempty"`
+	Policies         []string         `json:"policies,omitempty"`
+}
+
+// RequestingPartyTokenResult is the RPT returned by GetRequestingPartyToken
+type RequestingPartyTokenResult struct {
+	AccessToken      string `json:"access_token,omitempty"`
+	ExpiresIn        int    `json:"expires_in,omitempty"`
+	RefreshExpiresIn int    `json:"refresh_expires_in,omitempty"`
+	RefreshToken     string `json:"refresh_token,omitempty"`
+	TokenType        string `json:"token_type,omitempty"`
+}
+
+// CreateResource creates a resource for a client's authorization resource server
+func (g *GoCloak) CreateResource(ctx context.Context, token, realm, idOfClient string, resource ResourceRepresentation) (*ResourceRepresentation, error) {
+	var result ResourceRepresentation
+	resp, err := g.getRequest(ctx, token).
+		SetBody(resource).
+		SetResult(&result).
+		Post(g.getAdminRealmURL(realm, "clients", idOfClient, "authz", "resource-server", "resource"))
+	if err := checkForError(resp, err, "could not create resource"); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetResource returns a single resource of a client's authorization resource server
+func (g *GoCloak) GetResource(ctx context.Context, token, realm, idOfClient, resourceID string) (*ResourceRepresentation, error) {
+	var result ResourceRepresentation
+	resp, err := g.getRequest(ctx, token).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "clients", idOfClient, "authz", "resource-server", "resource", resourceID))
+	if err := checkForError(resp, err, "could not get resource"); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetResources returns all resources of a client's authorization resource server
+func (g *GoCloak) GetResources(ctx context.Context, token, realm, idOfClient string) ([]*ResourceRepresentation, error) {
+	var result []*ResourceRepresentation
+	resp, err := g.getRequest(ctx, token).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "clients", idOfClient, "authz", "resource-server", "resource"))
+	if err := checkForError(resp, err, "could not get resources"); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateResource updates a resource of a client's authorization resource server
+func (g *GoCloak) UpdateResource(ctx context.Context, token, realm, idOfClient string, resource ResourceRepresentation) error {
+	resp, err := g.getRequest(ctx, token).
+		SetBody(resource).
+		Put(g.getAdminRealmURL(realm, "clients", idOfClient, "authz", "resource-server", "resource", resource.ID))
+	return checkForError(resp, err, "could not update resource")
+}
+
+// DeleteResource deletes a resource from a client's authorization resource server
+func (g *GoCloak) DeleteResource(ctx context.Context, token, realm, idOfClient, resourceID string) error {
+	resp, err := g.getRequest(ctx, token).
+		Delete(g.getAdminRealmURL(realm, "clients", idOfClient, "authz", "resource-server", "resource", resourceID))
+	return checkForError(resp, err, "could not delete resource")
+}
+
+// CreateScope creates a scope for a client's authorization resource server
+func (g *GoCloak) CreateScope(ctx context.Context, token, realm, idOfClient string, scope ScopeRepresentation) (*ScopeRepresentation, error) {
+	var result ScopeRepresentation
+	resp, err := g.getRequest(ctx, token).
+		SetBody(scope).
+		SetResult(&result).
+		Post(g.getAdminRealmURL(realm, "clients", idOfClient, "authz", "resource-server", "scope"))
+	if err := checkForError(resp, err, "could not create scope"); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetScopes returns all scopes of a client's authorization resource server
+func (g *GoCloak) GetScopes(ctx context.Context, token, realm, idOfClient string) ([]*ScopeRepresentation, error) {
+	var result []*ScopeRepresentation
+	resp, err := g.getRequest(ctx, token).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "clients", idOfClient, "authz", "resource-server", "scope"))
+	if err := checkForError(resp, err, "could not get scopes"); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateScope updates a scope of a client's authorization resource server
+func (g *GoCloak) UpdateScope(ctx context.Context, token, realm, idOfClient string, scope ScopeRepresentation) error {
+	resp, err := g.getRequest(ctx, token).
+		SetBody(scope).
+		Put(g.getAdminRealmURL(realm, "clients", idOfClient, "authz", "resource-server", "scope", scope.ID))
+	return checkForError(resp, err, "could not update scope")
+}
+
+// DeleteScope deletes a scope from a client's authorization resource server
+func (g *GoCloak) DeleteScope(ctx context.Context, token, realm, idOfClient, scopeID string) error {
+	resp, err := g.getRequest(ctx, token).
+		Delete(g.getAdminRealmURL(realm, "clients", idOfClient, "authz", "resource-server", "scope", scopeID))
+	return checkForError(resp, err, "could not delete scope")
+}
+
+// CreatePolicy creates a policy for a client's authorization resource server.
+// policyType selects the sub-resource, e.g. "role", "user", "group", "js", "time", "aggregate", "client".
+func (g *GoCloak) CreatePolicy(ctx context.Context, token, realm, idOfClient, policyType string, policy PolicyRepresentation) (*PolicyRepresentation, error) {
+	var result PolicyRepresentation
+	resp, err := g.getRequest(ctx, token).
+		SetBody(policy).
+		SetResult(&result).
+		Post(g.getAdminRealmURL(realm, "clients", idOfClient, "authz", "resource-server", "policy", policyType))
+	if err := checkForError(resp, err, "could not create policy"); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetPolicies returns all policies of a client's authorization resource server
+func (g *GoCloak) GetPolicies(ctx context.Context, token, realm, idOfClient string) ([]*PolicyRepresentation, error) {
+	var result []*PolicyRepresentation
+	resp, err := g.getRequest(ctx, token).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "clients", idOfClient, "authz", "resource-server", "policy"))
+	if err := checkForError(resp, err, "could not get policies"); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdatePolicy updates a policy of a client's authorization resource server
+func (g *GoCloak) UpdatePolicy(ctx context.Context, token, realm, idOfClient, policyType string, policy PolicyRepresentation) error {
+	resp, err := g.getRequest(ctx, token).
+		SetBody(policy).
+		Put(g.getAdminRealmURL(realm, "clients", idOfClient, "authz", "resource-server", "policy", policyType, policy.ID))
+	return checkForError(resp, err, "could not update policy")
+}
+
+// DeletePolicy deletes a policy from a client's authorization resource server
+func (g *GoCloak) DeletePolicy(ctx context.Context, token, realm, idOfClient, policyID string) error {
+	resp, err := g.getRequest(ctx, token).
+		Delete(g.getAdminRealmURL(realm, "clients", idOfClient, "authz", "resource-server", "policy", policyID))
+	return checkForError(resp, err, "could not delete policy")
+}
+
+// CreatePermission creates a resource- or scope-based permission.
+// permissionType is "resource" or "scope".
+func (g *GoCloak) CreatePermission(ctx context.Context, token, realm, idOfClient, permissionType string, permission PermissionRepresentation) (*PermissionRepresentation, error) {
+	var result PermissionRepresentation
+	resp, err := g.getRequest(ctx, token).
+		SetBody(permission).
+		SetResult(&result).
+		Post(g.getAdminRealmURL(realm, "clients", idOfClient, "authz", "resource-server", "permission", permissionType))
+	if err := checkForError(resp, err, "could not create permission"); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetPermissions returns all permissions of a client's authorization resource server
+func (g *GoCloak) GetPermissions(ctx context.Context, token, realm, idOfClient string) ([]*PermissionRepresentation, error) {
+	var result []*PermissionRepresentation
+	resp, err := g.getRequest(ctx, token).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "clients", idOfClient, "authz", "resource-server", "permission"))
+	if err := checkForError(resp, err, "could not get permissions"); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdatePermission updates a resource- or scope-based permission
+func (g *GoCloak) UpdatePermission(ctx context.Context, token, realm, idOfClient, permissionType string, permission PermissionRepresentation) error {
+	resp, err := g.getRequest(ctx, token).
+		SetBody(permission).
+		Put(g.getAdminRealmURL(realm, "clients", idOfClient, "authz", "resource-server", "permission", permissionType, permission.ID))
+	return checkForError(resp, err, "could not update permission")
+}
+
+// DeletePermission deletes a permission from a client's authorization resource server
+func (g *GoCloak) DeletePermission(ctx context.Context, token, realm, idOfClient, permissionID string) error {
+	resp, err := g.getRequest(ctx, token).
+		Delete(g.getAdminRealmURL(realm, "clients", idOfClient, "authz", "resource-server", "permission", permissionID))
+	return checkForError(resp, err, "could not delete permission")
+}
+
+// GetRequestingPartyToken obtains an RPT for token through the uma-ticket grant, evaluating
+// the given permissions ("resourceID" or "resourceID#scope") against the policies of the
+// audience client. No permission ticket is sent; the request carries audience and permissions only.
+func (g *GoCloak) GetRequestingPartyToken(ctx context.Context, token, realm, audience string, permissions []string) (*RequestingPartyTokenResult, error) {
+	var result RequestingPartyTokenResult
+	request := g.getRequest(ctx, token).
+		SetFormData(map[string]string{
+			"grant_type": "urn:ietf:params:oauth:grant-type:uma-ticket",
+			"audience":   audience,
+		}).
+		SetResult(&result)
+	for _, permission := range permissions {
+		request.FormData.Add("permission", permission)
+	}
+	resp, err := request.Post(g.getRealmURL(realm, "protocol", "openid-connect", "token"))
+	if err := checkForError(resp, err, "could not obtain requesting party token"); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
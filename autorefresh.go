@@ -0,0 +1,122 @@
+package gocloak
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// refreshMargin is how long before expires_in the background refresher renews the token
+const refreshMargin = 5 * time.Second
+
+// AutoRefreshCredentials describes how to (re-)authenticate when a cached token needs
+// renewal and its refresh token can no longer be used. Set Username/Password for a
+// password grant, or leave them empty to use a client credentials grant.
+type AutoRefreshCredentials struct {
+	ClientID     string
+	ClientSecret string
+	Username     string
+	Password     string
+}
+
+// autoRefresher keeps a single token warm in the background for a GoCloak client
+type autoRefresher struct {
+	mu     sync.RWMutex
+	token  *JWT
+	cancel context.CancelFunc
+}
+
+func (r *autoRefresher) setToken(token *JWT) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.token = token
+}
+
+func (r *autoRefresher) getToken() *JWT {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.token
+}
+
+// StartAutoRefresh logs in and then keeps the resulting token warm in the background:
+// roughly refreshMargin before the token expires it is renewed using the refresh token,
+// falling back to a full login with creds if the refresh fails. onFailure is called when
+// both the refresh and the fallback login fail, so callers can retry, log, or panic.
+// Call StopAutoRefresh to stop the goroutine.
+func (g *GoCloak) StartAutoRefresh(ctx context.Context, realm string, creds AutoRefreshCredentials, onFailure func(error)) error {
+	token, err := g.loginWithCredentials(ctx, realm, creds)
+	if err != nil {
+		return err
+	}
+
+	g.autoRefreshMu.Lock()
+	defer g.autoRefreshMu.Unlock()
+	if g.autoRefresh != nil {
+		g.autoRefresh.cancel()
+	}
+
+	refreshCtx, cancel := context.WithCancel(ctx)
+	r := &autoRefresher{cancel: cancel}
+	r.setToken(token)
+	g.autoRefresh = r
+
+	go g.runAutoRefresh(refreshCtx, realm, creds, r, onFailure)
+	return nil
+}
+
+// StopAutoRefresh stops the background token refresher started by StartAutoRefresh, if any
+func (g *GoCloak) StopAutoRefresh() {
+	g.autoRefreshMu.Lock()
+	defer g.autoRefreshMu.Unlock()
+	if g.autoRefresh != nil {
+		g.autoRefresh.cancel()
+		g.autoRefresh = nil
+	}
+}
+
+// AutoRefreshToken returns the most recently refreshed token, or nil if StartAutoRefresh
+// was never called (or has since been stopped).
+func (g *GoCloak) AutoRefreshToken() *JWT {
+	g.autoRefreshMu.Lock()
+	r := g.autoRefresh
+	g.autoRefreshMu.Unlock()
+	if r == nil {
+		return nil
+	}
+	return r.getToken()
+}
+
+func (g *GoCloak) loginWithCredentials(ctx context.Context, realm string, creds AutoRefreshCredentials) (*JWT, error) {
+	if creds.Username != "" {
+		return g.Login(ctx, creds.ClientID, creds.ClientSecret, realm, creds.Username, creds.Password)
+	}
+	return g.LoginClient(ctx, creds.ClientID, creds.ClientSecret, realm)
+}
+
+func (g *GoCloak) runAutoRefresh(ctx context.Context, realm string, creds AutoRefreshCredentials, r *autoRefresher, onFailure func(error)) {
+	for {
+		token := r.getToken()
+		wait := time.Duration(token.ExpiresIn)*time.Second - refreshMargin
+		if wait < 0 {
+			wait = 0
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+
+		refreshed, err := g.RefreshToken(ctx, token.RefreshToken, creds.ClientID, creds.ClientSecret, realm)
+		if err != nil {
+			refreshed, err = g.loginWithCredentials(ctx, realm, creds)
+		}
+		if err != nil {
+			if onFailure != nil {
+				onFailure(err)
+			}
+			return
+		}
+		r.setToken(refreshed)
+	}
+}
@@ -0,0 +1,73 @@
+package gocloak
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// urlSeparator is used to join path segments when building admin REST URLs
+const urlSeparator = "/"
+
+// GoCloak holds the configuration needed to talk to a Keycloak instance
+type GoCloak struct {
+	basePath    string
+	restyClient *resty.Client
+
+	autoRefreshMu sync.Mutex
+	autoRefresh   *autoRefresher
+}
+
+// NewClient creates a new GoCloak client for the Keycloak instance reachable at basePath
+func NewClient(basePath string) *GoCloak {
+	return &GoCloak{
+		basePath:    strings.TrimRight(basePath, urlSeparator),
+		restyClient: resty.New(),
+	}
+}
+
+// RestyClient returns the internal resty client so callers can tune timeouts, retries, etc.
+func (g *GoCloak) RestyClient() *resty.Client {
+	return g.restyClient
+}
+
+// getRequest returns a resty request authenticated with the given bearer token. If token is
+// empty, it falls back to the token kept warm by StartAutoRefresh, so callers using
+// auto-refresh don't need to thread JWT.AccessToken through every call themselves.
+func (g *GoCloak) getRequest(ctx context.Context, token string) *resty.Request {
+	if token == "" {
+		if autoToken := g.AutoRefreshToken(); autoToken != nil {
+			token = autoToken.AccessToken
+		}
+	}
+	return g.restyClient.R().
+		SetContext(ctx).
+		SetAuthToken(token)
+}
+
+// getAdminRealmURL builds an admin REST URL under /admin/realms/{realm}/...
+func (g *GoCloak) getAdminRealmURL(realm string, path ...string) string {
+	path = append([]string{g.basePath, "admin", "realms", realm}, path...)
+	return strings.Join(path, urlSeparator)
+}
+
+// getAdminURL builds an admin REST URL under /admin/... that is not scoped to a single realm
+func (g *GoCloak) getAdminURL(path ...string) string {
+	path = append([]string{g.basePath, "admin"}, path...)
+	return strings.Join(path, urlSeparator)
+}
+
+// getRealmURL builds a realm-facing REST URL under /realms/{realm}/...
+func (g *GoCloak) getRealmURL(realm string, path ...string) string {
+	path = append([]string{g.basePath, "realms", realm}, path...)
+	return strings.Join(path, urlSeparator)
+}
+
+// getIDFromLocationHeader extracts the trailing path segment of a Location header, as
+// returned by Keycloak's POST endpoints that respond 201 with no body (e.g. components)
+func getIDFromLocationHeader(location string) string {
+	parts := strings.Split(location, urlSeparator)
+	return parts[len(parts)-1]
+}
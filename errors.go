@@ -0,0 +1,98 @@
+package gocloak
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// APIError represents a failed Keycloak API call. Code is the HTTP status code (0 if the
+// request never reached the server); Body is the raw response body, preserved for callers
+// that need more than Message gives them.
+type APIError struct {
+	Code    int
+	Message string
+	Body    []byte
+
+	err error
+}
+
+// Error stringifies the APIError
+func (apiError *APIError) Error() string {
+	return apiError.Message
+}
+
+// Unwrap exposes the underlying transport error, if the request never reached the server
+func (apiError *APIError) Unwrap() error {
+	return apiError.err
+}
+
+// keycloakErrorBody matches the two JSON error shapes Keycloak returns: OAuth2-style
+// {"error": "...", "error_description": "..."} from the token endpoint, and
+// {"errorMessage": "..."} from the admin REST API.
+type keycloakErrorBody struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+	ErrorMessage     string `json:"errorMessage"`
+}
+
+// describeErrorBody extracts a human-readable message from a Keycloak error response body,
+// falling back to the HTTP status line if the body isn't one of the known JSON shapes
+func describeErrorBody(resp *resty.Response) string {
+	var body keycloakErrorBody
+	if err := json.Unmarshal(resp.Body(), &body); err == nil {
+		switch {
+		case body.ErrorMessage != "":
+			return body.ErrorMessage
+		case body.ErrorDescription != "":
+			return body.Error + ": " + body.ErrorDescription
+		case body.Error != "":
+			return body.Error
+		}
+	}
+	return resp.Status()
+}
+
+// checkForError turns a failed resty call into an *APIError, or nil on success
+func checkForError(resp *resty.Response, err error, errMessage string) error {
+	if err != nil {
+		return &APIError{
+			Message: errMessage + ": " + err.Error(),
+			err:     err,
+		}
+	}
+	if resp == nil || !resp.IsError() {
+		return nil
+	}
+	return &APIError{
+		Code:    resp.StatusCode(),
+		Message: errMessage + ": " + describeErrorBody(resp),
+		Body:    resp.Body(),
+	}
+}
+
+// hasStatusCode reports whether err is an *APIError carrying the given HTTP status code
+func hasStatusCode(err error, code int) bool {
+	var apiError *APIError
+	if errors.As(err, &apiError) {
+		return apiError.Code == code
+	}
+	return false
+}
+
+// IsNotFound reports whether err is an *APIError for a 404 response
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is an *APIError for a 409 response
+func IsConflict(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+// IsUnauthorized reports whether err is an *APIError for a 401 response
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized)
+}
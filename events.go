@@ -0,0 +1,159 @@
+package gocloak
+
+import (
+	"context"
+	"net/url"
+)
+
+// AuthDetailsRepresentation identifies who performed an admin action and from where
+type AuthDetailsRepresentation struct {
+	RealmID   string `json:"realmId,omitempty"`
+	ClientID  string `json:"clientId,omitempty"`
+	UserID    string `json:"userId,omitempty"`
+	IPAddress string `json:"ipAddress,omitempty"`
+}
+
+// EventRepresentation is a single entry of a realm's user-facing event log
+// (logins, registrations, password resets, ...)
+type EventRepresentation struct {
+	Time      int64             `json:"time,omitempty"`
+	Type      string            `json:"type,omitempty"`
+	RealmID   string            `json:"realmId,omitempty"`
+	ClientID  string            `json:"clientId,omitempty"`
+	UserID    string            `json:"userId,omitempty"`
+	SessionID string            `json:"sessionId,omitempty"`
+	IPAddress string            `json:"ipAddress,omitempty"`
+	Error     string            `json:"error,omitempty"`
+	Details   map[string]string `json:"details,omitempty"`
+}
+
+// AdminEventRepresentation is a single entry of a realm's admin event log
+// (changes made through the admin REST API or console)
+type AdminEventRepresentation struct {
+	Time           int64                     `json:"time,omitempty"`
+	RealmID        string                    `json:"realmId,omitempty"`
+	AuthDetails    AuthDetailsRepresentation `json:"authDetails,omitempty"`
+	OperationType  string                    `json:"operationType,omitempty"`
+	ResourceType   string                    `json:"resourceType,omitempty"`
+	ResourcePath   string                    `json:"resourcePath,omitempty"`
+	Representation string                    `json:"representation,omitempty"`
+	Error          string                    `json:"error,omitempty"`
+}
+
+// RealmEventsConfigRepresentation is a realm's events logging configuration
+type RealmEventsConfigRepresentation struct {
+	EventsEnabled             *bool    `json:"eventsEnabled,omitempty"`
+	EventsExpiration          *int64   `json:"eventsExpiration,omitempty"`
+	EventsListeners           []string `json:"eventsListeners,omitempty"`
+	EnabledEventTypes         []string `json:"enabledEventTypes,omitempty"`
+	AdminEventsEnabled        *bool    `json:"adminEventsEnabled,omitempty"`
+	AdminEventsDetailsEnabled *bool    `json:"adminEventsDetailsEnabled,omitempty"`
+}
+
+// GetEventsParams represents the optional parameters for getting user events
+type GetEventsParams struct {
+	BaseParams
+	Client    *string  `json:"client,omitempty"`
+	DateFrom  *string  `json:"dateFrom,omitempty"`
+	DateTo    *string  `json:"dateTo,omitempty"`
+	First     *int     `json:"first,omitempty"`
+	IPAddress *string  `json:"ipAddress,omitempty"`
+	Max       *int     `json:"max,omitempty"`
+	Type      []string `json:"type,omitempty"`
+	User      *string  `json:"user,omitempty"`
+}
+
+// GetQueryParams converts the struct to url.Values
+func (s GetEventsParams) GetQueryParams() (url.Values, error) {
+	return GetQueryParams(s)
+}
+
+// GetAdminEventsParams represents the optional parameters for getting admin events
+type GetAdminEventsParams struct {
+	BaseParams
+	AuthClient     *string  `json:"authClient,omitempty"`
+	AuthIPAddress  *string  `json:"authIpAddress,omitempty"`
+	AuthRealm      *string  `json:"authRealm,omitempty"`
+	AuthUser       *string  `json:"authUser,omitempty"`
+	Client         *string  `json:"client,omitempty"`
+	DateFrom       *string  `json:"dateFrom,omitempty"`
+	DateTo         *string  `json:"dateTo,omitempty"`
+	First          *int     `json:"first,omitempty"`
+	Max            *int     `json:"max,omitempty"`
+	OperationTypes []string `json:"operationTypes,omitempty"`
+	ResourcePath   *string  `json:"resourcePath,omitempty"`
+	ResourceTypes  []string `json:"resourceTypes,omitempty"`
+}
+
+// GetQueryParams converts the struct to url.Values
+func (s GetAdminEventsParams) GetQueryParams() (url.Values, error) {
+	return GetQueryParams(s)
+}
+
+// GetEvents returns the user-facing event log of a realm, optionally filtered by params
+func (g *GoCloak) GetEvents(ctx context.Context, token, realm string, params GetEventsParams) ([]*EventRepresentation, error) {
+	queryParams, err := params.GetQueryParams()
+	if err != nil {
+		return nil, err
+	}
+	var result []*EventRepresentation
+	resp, err := g.getRequest(ctx, token).
+		SetQueryParamsFromValues(queryParams).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "events"))
+	if err := checkForError(resp, err, "could not get events"); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ClearEvents deletes all stored user-facing events of a realm
+func (g *GoCloak) ClearEvents(ctx context.Context, token, realm string) error {
+	resp, err := g.getRequest(ctx, token).
+		Delete(g.getAdminRealmURL(realm, "events"))
+	return checkForError(resp, err, "could not clear events")
+}
+
+// GetAdminEvents returns the admin event log of a realm, optionally filtered by params
+func (g *GoCloak) GetAdminEvents(ctx context.Context, token, realm string, params GetAdminEventsParams) ([]*AdminEventRepresentation, error) {
+	queryParams, err := params.GetQueryParams()
+	if err != nil {
+		return nil, err
+	}
+	var result []*AdminEventRepresentation
+	resp, err := g.getRequest(ctx, token).
+		SetQueryParamsFromValues(queryParams).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "admin-events"))
+	if err := checkForError(resp, err, "could not get admin events"); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ClearAdminEvents deletes all stored admin events of a realm
+func (g *GoCloak) ClearAdminEvents(ctx context.Context, token, realm string) error {
+	resp, err := g.getRequest(ctx, token).
+		Delete(g.getAdminRealmURL(realm, "admin-events"))
+	return checkForError(resp, err, "could not clear admin events")
+}
+
+// GetEventsConfig returns a realm's events logging configuration
+func (g *GoCloak) GetEventsConfig(ctx context.Context, token, realm string) (*RealmEventsConfigRepresentation, error) {
+	var result RealmEventsConfigRepresentation
+	resp, err := g.getRequest(ctx, token).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "events", "config"))
+	if err := checkForError(resp, err, "could not get events config"); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateEventsConfig updates a realm's events logging configuration
+func (g *GoCloak) UpdateEventsConfig(ctx context.Context, token, realm string, config RealmEventsConfigRepresentation) error {
+	resp, err := g.getRequest(ctx, token).
+		SetBody(config).
+		Put(g.getAdminRealmURL(realm, "events", "config"))
+	return checkForError(resp, err, "could not update events config")
+}
@@ -0,0 +1,147 @@
+package gocloak
+
+import "context"
+
+// IdentityProviderRepresentation is a broker to an external identity provider (SAML, OIDC, social logins, ...)
+type IdentityProviderRepresentation struct {
+	Alias                     string            `json:"alias,omitempty"`
+	DisplayName               string            `json:"displayName,omitempty"`
+	ProviderID                string            `json:"providerId,omitempty"`
+	Enabled                   bool              `json:"enabled"`
+	StoreToken                bool              `json:"storeToken"`
+	AddReadTokenRoleOnCreate  bool              `json:"addReadTokenRoleOnCreate"`
+	TrustEmail                bool              `json:"trustEmail"`
+	FirstBrokerLoginFlowAlias string            `json:"firstBrokerLoginFlowAlias,omitempty"`
+	PostBrokerLoginFlowAlias  string            `json:"postBrokerLoginFlowAlias,omitempty"`
+	LinkOnly                  bool              `json:"linkOnly"`
+	InternalID                string            `json:"internalId,omitempty"`
+	Config                    map[string]string `json:"config,omitempty"`
+}
+
+// IdentityProviderMapperRepresentation maps claims/attributes from an identity provider
+// onto the local user being federated in
+type IdentityProviderMapperRepresentation struct {
+	ID                     string            `json:"id,omitempty"`
+	Name                   string            `json:"name,omitempty"`
+	IdentityProviderAlias  string            `json:"identityProviderAlias,omitempty"`
+	IdentityProviderMapper string            `json:"identityProviderMapper,omitempty"`
+	Config                 map[string]string `json:"config,omitempty"`
+}
+
+// CreateIdentityProvider creates an identity provider in the given realm and returns its alias
+func (g *GoCloak) CreateIdentityProvider(ctx context.Context, token, realm string, provider IdentityProviderRepresentation) (string, error) {
+	resp, err := g.getRequest(ctx, token).
+		SetBody(provider).
+		Post(g.getAdminRealmURL(realm, "identity-provider", "instances"))
+	if err := checkForError(resp, err, "could not create identity provider"); err != nil {
+		return "", err
+	}
+	return getIDFromLocationHeader(resp.Header().Get("Location")), nil
+}
+
+// GetIdentityProvider returns a single identity provider of a realm
+func (g *GoCloak) GetIdentityProvider(ctx context.Context, token, realm, alias string) (*IdentityProviderRepresentation, error) {
+	var result IdentityProviderRepresentation
+	resp, err := g.getRequest(ctx, token).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "identity-provider", "instances", alias))
+	if err := checkForError(resp, err, "could not get identity provider"); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetIdentityProviders returns all identity providers of a realm
+func (g *GoCloak) GetIdentityProviders(ctx context.Context, token, realm string) ([]*IdentityProviderRepresentation, error) {
+	var result []*IdentityProviderRepresentation
+	resp, err := g.getRequest(ctx, token).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "identity-provider", "instances"))
+	if err := checkForError(resp, err, "could not get identity providers"); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateIdentityProvider updates an identity provider of a realm
+func (g *GoCloak) UpdateIdentityProvider(ctx context.Context, token, realm, alias string, provider IdentityProviderRepresentation) error {
+	resp, err := g.getRequest(ctx, token).
+		SetBody(provider).
+		Put(g.getAdminRealmURL(realm, "identity-provider", "instances", alias))
+	return checkForError(resp, err, "could not update identity provider")
+}
+
+// DeleteIdentityProvider deletes an identity provider from a realm
+func (g *GoCloak) DeleteIdentityProvider(ctx context.Context, token, realm, alias string) error {
+	resp, err := g.getRequest(ctx, token).
+		Delete(g.getAdminRealmURL(realm, "identity-provider", "instances", alias))
+	return checkForError(resp, err, "could not delete identity provider")
+}
+
+// ImportIdentityProviderConfig fetches provider config (keys, endpoints, ...) from an OIDC
+// discovery document or SAML metadata URL, for use as the Config of a subsequent
+// CreateIdentityProvider call
+func (g *GoCloak) ImportIdentityProviderConfig(ctx context.Context, token, realm, fromURL, providerID string) (map[string]string, error) {
+	var result map[string]string
+	resp, err := g.getRequest(ctx, token).
+		SetFormData(map[string]string{
+			"fromUrl":    fromURL,
+			"providerId": providerID,
+		}).
+		SetResult(&result).
+		Post(g.getAdminRealmURL(realm, "identity-provider", "import-config"))
+	if err := checkForError(resp, err, "could not import identity provider config"); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CreateIdentityProviderMapper creates a mapper for an identity provider and returns its ID
+func (g *GoCloak) CreateIdentityProviderMapper(ctx context.Context, token, realm, alias string, mapper IdentityProviderMapperRepresentation) (string, error) {
+	resp, err := g.getRequest(ctx, token).
+		SetBody(mapper).
+		Post(g.getAdminRealmURL(realm, "identity-provider", "instances", alias, "mappers"))
+	if err := checkForError(resp, err, "could not create identity provider mapper"); err != nil {
+		return "", err
+	}
+	return getIDFromLocationHeader(resp.Header().Get("Location")), nil
+}
+
+// GetIdentityProviderMapper returns a single mapper of an identity provider
+func (g *GoCloak) GetIdentityProviderMapper(ctx context.Context, token, realm, alias, mapperID string) (*IdentityProviderMapperRepresentation, error) {
+	var result IdentityProviderMapperRepresentation
+	resp, err := g.getRequest(ctx, token).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "identity-provider", "instances", alias, "mappers", mapperID))
+	if err := checkForError(resp, err, "could not get identity provider mapper"); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetIdentityProviderMappers returns all mappers of an identity provider
+func (g *GoCloak) GetIdentityProviderMappers(ctx context.Context, token, realm, alias string) ([]*IdentityProviderMapperRepresentation, error) {
+	var result []*IdentityProviderMapperRepresentation
+	resp, err := g.getRequest(ctx, token).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "identity-provider", "instances", alias, "mappers"))
+	if err := checkForError(resp, err, "could not get identity provider mappers"); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateIdentityProviderMapper updates a mapper of an identity provider
+func (g *GoCloak) UpdateIdentityProviderMapper(ctx context.Context, token, realm, alias string, mapper IdentityProviderMapperRepresentation) error {
+	resp, err := g.getRequest(ctx, token).
+		SetBody(mapper).
+		Put(g.getAdminRealmURL(realm, "identity-provider", "instances", alias, "mappers", mapper.ID))
+	return checkForError(resp, err, "could not update identity provider mapper")
+}
+
+// DeleteIdentityProviderMapper deletes a mapper from an identity provider
+func (g *GoCloak) DeleteIdentityProviderMapper(ctx context.Context, token, realm, alias, mapperID string) error {
+	resp, err := g.getRequest(ctx, token).
+		Delete(g.getAdminRealmURL(realm, "identity-provider", "instances", alias, "mappers", mapperID))
+	return checkForError(resp, err, "could not delete identity provider mapper")
+}
@@ -0,0 +1,46 @@
+package gocloak
+
+import "context"
+
+// Login exchanges a resource owner password credentials grant for a token
+func (g *GoCloak) Login(ctx context.Context, clientID, clientSecret, realm, username, password string) (*JWT, error) {
+	return g.login(ctx, realm, map[string]string{
+		"grant_type":    "password",
+		"client_id":     clientID,
+		"client_secret": clientSecret,
+		"username":      username,
+		"password":      password,
+	})
+}
+
+// LoginClient exchanges a client credentials grant for a token
+func (g *GoCloak) LoginClient(ctx context.Context, clientID, clientSecret, realm string) (*JWT, error) {
+	return g.login(ctx, realm, map[string]string{
+		"grant_type":    "client_credentials",
+		"client_id":     clientID,
+		"client_secret": clientSecret,
+	})
+}
+
+// RefreshToken exchanges a refresh token for a new token
+func (g *GoCloak) RefreshToken(ctx context.Context, refreshToken, clientID, clientSecret, realm string) (*JWT, error) {
+	return g.login(ctx, realm, map[string]string{
+		"grant_type":    "refresh_token",
+		"refresh_token": refreshToken,
+		"client_id":     clientID,
+		"client_secret": clientSecret,
+	})
+}
+
+func (g *GoCloak) login(ctx context.Context, realm string, formData map[string]string) (*JWT, error) {
+	var result JWT
+	resp, err := g.restyClient.R().
+		SetContext(ctx).
+		SetFormData(formData).
+		SetResult(&result).
+		Post(g.getRealmURL(realm, "protocol", "openid-connect", "token"))
+	if err := checkForError(resp, err, "could not obtain token"); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
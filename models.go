@@ -1,41 +1,15 @@
 package gocloak
 
-import (
-	"encoding/json"
-	"strconv"
-)
-
-// BaseParams provides basic functionality for all QueryParams structures.
-// The fields tags must have `json:"<name>,string,omitempty"` format.
-// "string" tag allows to convert the structure to map[string]string.
-// "omitempty" allows to skip the fields with default values.
+import "net/url"
+
+// BaseParams is embedded by all QueryParams structures. Concrete types define their own
+// GetQueryParams method that delegates to the package-level GetQueryParams encoder in
+// queryparams.go, since a promoted method on BaseParams would only ever see the embedded
+// zero-value struct, never the concrete type's fields. Field tags must have the
+// `json:"<name>,omitempty"` format; pointer fields (*bool, *int, *string, ...) let callers
+// distinguish "unset" from the zero value.
 type BaseParams struct{}
 
-// GetQueryParams converts the struct to map[string]string
-func (s BaseParams) GetQueryParams() (map[string]string, error) {
-	b, err := json.Marshal(s)
-	if err != nil {
-		return nil, err
-	}
-	var res map[string]string
-	err = json.Unmarshal(b, &res)
-	if err != nil {
-		return nil, err
-	}
-	return res, nil
-}
-
-// APIError represents an api error
-type APIError struct {
-	Code    int
-	Message string
-}
-
-// Error stringifies the APIError
-func (apiError APIError) Error() string {
-	return apiError.Message
-}
-
 // CertResponseKey is returned by the certs endpoint
 type CertResponseKey struct {
 	Kid string `json:"kid"`
@@ -60,6 +34,18 @@ type IssuerResponse struct {
 	TokensNotBefore int    `json:"tokens-not-before"`
 }
 
+// JWT is the token response returned by the Keycloak token endpoint
+type JWT struct {
+	AccessToken      string `json:"access_token,omitempty"`
+	ExpiresIn        int    `json:"expires_in,omitempty"`
+	RefreshExpiresIn int    `json:"refresh_expires_in,omitempty"`
+	RefreshToken     string `json:"refresh_token,omitempty"`
+	TokenType        string `json:"token_type,omitempty"`
+	NotBeforePolicy  int    `json:"not-before-policy,omitempty"`
+	SessionState     string `json:"session_state,omitempty"`
+	Scope            string `json:"scope,omitempty"`
+}
+
 // RetrospecTokenResult is returned when a token was checked
 type RetrospecTokenResult struct {
 	Permissions map[string]string `json:"permissions,omitempty"`
@@ -165,42 +151,36 @@ type UserGroup struct {
 // GetUsersParams represents the optional parameters for getting users
 type GetUsersParams struct {
 	BaseParams
-	BriefRepresentation *bool  `json:"briefRepresentation,string,omitempty"`
-	Email               string `json:"email,string,omitempty"`
-	First               int    `json:"first,string,omitempty"`
-	FirstName           string `json:"firstName,string,omitempty"`
-	LastName            string `json:"lastName,string,omitempty"`
-	Max                 int    `json:"max,string,omitempty"`
-	Search              string `json:"search,string,omitempty"`
-	Username            string `json:"username,string,omitempty"`
+	BriefRepresentation *bool   `json:"briefRepresentation,omitempty"`
+	Email               *string `json:"email,omitempty"`
+	First               *int    `json:"first,omitempty"`
+	FirstName           *string `json:"firstName,omitempty"`
+	LastName            *string `json:"lastName,omitempty"`
+	Max                 *int    `json:"max,omitempty"`
+	Search              *string `json:"search,omitempty"`
+	Username            *string `json:"username,omitempty"`
 }
 
-// GetQueryParams converts the struct to map[string]string
-func (s GetUsersParams) GetQueryParams() (map[string]string, error) {
-	var res map[string]string
-
-	res["briefRepresentation"] = strconv.FormatBool(*s.BriefRepresentation)
-	res["email"] = s.Email
-	res["first"] = strconv.FormatInt(int64(s.First), 10)
-	res["firstName"] = s.FirstName
-	res["lastName"] = s.LastName
-	res["max"] = strconv.FormatInt(int64(s.Max), 10)
-	res["search"] = s.Search
-	res["username"] = s.Username
-
-	return res, nil
+// GetQueryParams converts the struct to url.Values
+func (s GetUsersParams) GetQueryParams() (url.Values, error) {
+	return GetQueryParams(s)
 }
 
 // ExecuteActionsEmail represents parameters for executing action emails
 type ExecuteActionsEmail struct {
 	BaseParams
 	UserID      string   `json:"-"`
-	ClientID    string   `json:"clientId,string,omitempty"`
-	Lifespan    int      `json:"lifespan,string,omitempty"`
-	RedirectURI string   `json:"redirect_uri,string,omitempty"`
+	ClientID    *string  `json:"clientId,omitempty"`
+	Lifespan    *int     `json:"lifespan,omitempty"`
+	RedirectURI *string  `json:"redirect_uri,omitempty"`
 	Actions     []string `json:"-"`
 }
 
+// GetQueryParams converts the struct to url.Values
+func (s ExecuteActionsEmail) GetQueryParams() (url.Values, error) {
+	return GetQueryParams(s)
+}
+
 // Group is a Group
 type Group struct {
 	ID        string        `json:"id,omitempty"`
@@ -212,9 +192,14 @@ type Group struct {
 // GetGroupsParams represents the optional parameters for getting groups
 type GetGroupsParams struct {
 	BaseParams
-	First  int    `json:"first,string,omitempty"`
-	Max    int    `json:"max,string,omitempty"`
-	Search string `json:"search,string,omitempty"`
+	First  *int    `json:"first,omitempty"`
+	Max    *int    `json:"max,omitempty"`
+	Search *string `json:"search,omitempty"`
+}
+
+// GetQueryParams converts the struct to url.Values
+func (s GetGroupsParams) GetQueryParams() (url.Values, error) {
+	return GetQueryParams(s)
 }
 
 // Role is a role
@@ -287,8 +272,13 @@ type Client struct {
 // GetClientsParams represents the query parameters
 type GetClientsParams struct {
 	BaseParams
-	ClientID     string `json:"clientId,string,omitempty"`
-	ViewableOnly bool   `json:"viewableOnly,string,omitempty"`
+	ClientID     *string `json:"clientId,omitempty"`
+	ViewableOnly *bool   `json:"viewableOnly,omitempty"`
+}
+
+// GetQueryParams converts the struct to url.Values
+func (s GetClientsParams) GetQueryParams() (url.Values, error) {
+	return GetQueryParams(s)
 }
 
 // UserInfo is returned by the userinfo endpoint
@@ -302,99 +292,99 @@ type UserInfo struct {
 
 // RealmRepresentation represent a realm
 type RealmRepresentation struct {
-	AccessCodeLifespan                  int               `json:"accessCodeLifespan"`
-	AccessCodeLifespanLogin             int               `json:"accessCodeLifespanLogin"`
-	AccessCodeLifespanUserAction        int               `json:"accessCodeLifespanUserAction"`
-	AccessTokenLifespan                 int               `json:"accessTokenLifespan"`
-	AccessTokenLifespanForImplicitFlow  int               `json:"accessTokenLifespanForImplicitFlow"`
-	AccountTheme                        string            `json:"accountTheme"`
-	ActionTokenGeneratedByAdminLifespan int               `json:"actionTokenGeneratedByAdminLifespan"`
-	ActionTokenGeneratedByUserLifespan  int               `json:"actionTokenGeneratedByUserLifespan"`
-	AdminEventsDetailsEnabled           bool              `json:"adminEventsDetailsEnabled"`
-	AdminEventsEnabled                  bool              `json:"adminEventsEnabled"`
-	AdminTheme                          string            `json:"adminTheme"`
-	Attributes                          map[string]string `json:"attributes"`
-	AuthenticationFlows                 []interface{}     `json:"authenticationFlows"`
-	AuthenticatorConfig                 []interface{}     `json:"authenticatorConfig"`
-	BrowserFlow                         string            `json:"browserFlow"`
-	BrowserSecurityHeaders              map[string]string `json:"browserSecurityHeaders"`
-	BruteForceProtected                 bool              `json:"bruteForceProtected"`
-	ClientAuthenticationFlow            string            `json:"clientAuthenticationFlow"`
-	ClientScopeMappings                 map[string]string `json:"clientScopeMappings"`
-	ClientScopes                        []interface{}     `json:"clientScopes"`
-	Clients                             []interface{}     `json:"clients"`
-	Components                          interface{}       `json:"components"`
-	DefaultDefaultClientScopes          []string          `json:"defaultDefaultClientScopes"`
-	DefaultGroups                       []string          `json:"defaultGroups"`
-	DefaultLocale                       string            `json:"defaultLocale"`
-	DefaultOptionalClientScopes         []string          `json:"defaultOptionalClientScopes"`
-	DefaultRoles                        []string          `json:"defaultRoles"`
-	DefaultSignatureAlgorithm           string            `json:"defaultSignatureAlgorithm"`
-	DirectGrantFlow                     string            `json:"directGrantFlow"`
-	DisplayName                         string            `json:"displayName"`
-	DisplayNameHTML                     string            `json:"displayNameHtml"`
-	DockerAuthenticationFlow            string            `json:"dockerAuthenticationFlow"`
-	DuplicateEmailsAllowed              bool              `json:"duplicateEmailsAllowed"`
-	EditUsernameAllowed                 bool              `json:"editUsernameAllowed"`
-	EmailTheme                          string            `json:"emailTheme"`
-	Enabled                             bool              `json:"enabled"`
-	EnabledEventTypes                   []string          `json:"enabledEventTypes"`
-	EventsEnabled                       bool              `json:"eventsEnabled"`
-	EventsExpiration                    int64             `json:"eventsExpiration"`
-	EventsListeners                     []string          `json:"eventsListeners"`
-	FailureFactor                       int               `json:"failureFactor"`
-	FederatedUsers                      []interface{}     `json:"federatedUsers"`
-	Groups                              []interface{}     `json:"groups"`
-	ID                                  string            `json:"id"`
-	IdentityProviderMappers             []interface{}     `json:"identityProviderMappers"`
-	IdentityProviders                   []interface{}     `json:"identityProviders"`
-	InternationalizationEnabled         bool              `json:"internationalizationEnabled"`
-	KeycloakVersion                     string            `json:"keycloakVersion"`
-	LoginTheme                          string            `json:"loginTheme"`
-	LoginWithEmailAllowed               bool              `json:"loginWithEmailAllowed"`
-	MaxDeltaTimeSeconds                 int               `json:"maxDeltaTimeSeconds"`
-	MaxFailureWaitSeconds               int               `json:"maxFailureWaitSeconds"`
-	MinimumQuickLoginWaitSeconds        int               `json:"minimumQuickLoginWaitSeconds"`
-	NotBefore                           int               `json:"notBefore"`
-	OfflineSessionIdleTimeout           int               `json:"offlineSessionIdleTimeout"`
-	OfflineSessionMaxLifespan           int               `json:"offlineSessionMaxLifespan"`
-	OfflineSessionMaxLifespanEnabled    bool              `json:"offlineSessionMaxLifespanEnabled"`
-	OtpPolicyAlgorithm                  string            `json:"otpPolicyAlgorithm"`
-	OtpPolicyDigits                     int               `json:"otpPolicyDigits"`
-	OtpPolicyInitialCounter             int               `json:"otpPolicyInitialCounter"`
-	OtpPolicyLookAheadWindow            int               `json:"otpPolicyLookAheadWindow"`
-	OtpPolicyPeriod                     int               `json:"otpPolicyPeriod"`
-	OtpPolicyType                       string            `json:"otpPolicyType"`
-	OtpSupportedApplications            []string          `json:"otpSupportedApplications"`
-	PasswordPolicy                      string            `json:"passwordPolicy"`
-	PermanentLockout                    bool              `json:"permanentLockout"`
-	ProtocolMappers                     []interface{}     `json:"protocolMappers"`
-	QuickLoginCheckMilliSeconds         int64             `json:"quickLoginCheckMilliSeconds"`
-	Realm                               string            `json:"realm"`
-	RefreshTokenMaxReuse                int               `json:"refreshTokenMaxReuse"`
-	RegistrationAllowed                 bool              `json:"registrationAllowed"`
-	RegistrationEmailAsUsername         bool              `json:"registrationEmailAsUsername"`
-	RegistrationFlow                    string            `json:"registrationFlow"`
-	RememberMe                          bool              `json:"rememberMe"`
-	RequiredActions                     []interface{}     `json:"requiredActions"`
-	ResetCredentialsFlow                string            `json:"resetCredentialsFlow"`
-	ResetPasswordAllowed                bool              `json:"resetPasswordAllowed"`
-	RevokeRefreshToken                  bool              `json:"revokeRefreshToken"`
-	Roles                               interface{}       `json:"roles"`
-	ScopeMappings                       []interface{}     `json:"scopeMappings"`
-	SMTPServer                          map[string]string `json:"smtpServer"`
-	SslRequired                         string            `json:"sslRequired"`
-	SsoSessionIdleTimeout               int               `json:"ssoSessionIdleTimeout"`
-	SsoSessionIdleTimeoutRememberMe     int               `json:"ssoSessionIdleTimeoutRememberMe"`
-	SsoSessionMaxLifespan               int               `json:"ssoSessionMaxLifespan"`
-	SsoSessionMaxLifespanRememberMe     int               `json:"ssoSessionMaxLifespanRememberMe"`
-	SupportedLocales                    []string          `json:"supportedLocales"`
-	UserFederationMappers               []interface{}     `json:"userFederationMappers"`
-	UserFederationProviders             []interface{}     `json:"userFederationProviders"`
-	UserManagedAccessAllowed            bool              `json:"userManagedAccessAllowed"`
-	Users                               []interface{}     `json:"users"`
-	VerifyEmail                         bool              `json:"verifyEmail"`
-	WaitIncrementSeconds                int               `json:"waitIncrementSeconds"`
+	AccessCodeLifespan                  int                                    `json:"accessCodeLifespan"`
+	AccessCodeLifespanLogin             int                                    `json:"accessCodeLifespanLogin"`
+	AccessCodeLifespanUserAction        int                                    `json:"accessCodeLifespanUserAction"`
+	AccessTokenLifespan                 int                                    `json:"accessTokenLifespan"`
+	AccessTokenLifespanForImplicitFlow  int                                    `json:"accessTokenLifespanForImplicitFlow"`
+	AccountTheme                        string                                 `json:"accountTheme"`
+	ActionTokenGeneratedByAdminLifespan int                                    `json:"actionTokenGeneratedByAdminLifespan"`
+	ActionTokenGeneratedByUserLifespan  int                                    `json:"actionTokenGeneratedByUserLifespan"`
+	AdminEventsDetailsEnabled           bool                                   `json:"adminEventsDetailsEnabled"`
+	AdminEventsEnabled                  bool                                   `json:"adminEventsEnabled"`
+	AdminTheme                          string                                 `json:"adminTheme"`
+	Attributes                          map[string]string                      `json:"attributes"`
+	AuthenticationFlows                 []interface{}                          `json:"authenticationFlows"`
+	AuthenticatorConfig                 []interface{}                          `json:"authenticatorConfig"`
+	BrowserFlow                         string                                 `json:"browserFlow"`
+	BrowserSecurityHeaders              map[string]string                      `json:"browserSecurityHeaders"`
+	BruteForceProtected                 bool                                   `json:"bruteForceProtected"`
+	ClientAuthenticationFlow            string                                 `json:"clientAuthenticationFlow"`
+	ClientScopeMappings                 map[string]string                      `json:"clientScopeMappings"`
+	ClientScopes                        []interface{}                          `json:"clientScopes"`
+	Clients                             []interface{}                          `json:"clients"`
+	Components                          interface{}                            `json:"components"`
+	DefaultDefaultClientScopes          []string                               `json:"defaultDefaultClientScopes"`
+	DefaultGroups                       []string                               `json:"defaultGroups"`
+	DefaultLocale                       string                                 `json:"defaultLocale"`
+	DefaultOptionalClientScopes         []string                               `json:"defaultOptionalClientScopes"`
+	DefaultRoles                        []string                               `json:"defaultRoles"`
+	DefaultSignatureAlgorithm           string                                 `json:"defaultSignatureAlgorithm"`
+	DirectGrantFlow                     string                                 `json:"directGrantFlow"`
+	DisplayName                         string                                 `json:"displayName"`
+	DisplayNameHTML                     string                                 `json:"displayNameHtml"`
+	DockerAuthenticationFlow            string                                 `json:"dockerAuthenticationFlow"`
+	DuplicateEmailsAllowed              bool                                   `json:"duplicateEmailsAllowed"`
+	EditUsernameAllowed                 bool                                   `json:"editUsernameAllowed"`
+	EmailTheme                          string                                 `json:"emailTheme"`
+	Enabled                             bool                                   `json:"enabled"`
+	EnabledEventTypes                   []string                               `json:"enabledEventTypes"`
+	EventsEnabled                       bool                                   `json:"eventsEnabled"`
+	EventsExpiration                    int64                                  `json:"eventsExpiration"`
+	EventsListeners                     []string                               `json:"eventsListeners"`
+	FailureFactor                       int                                    `json:"failureFactor"`
+	FederatedUsers                      []interface{}                          `json:"federatedUsers"`
+	Groups                              []interface{}                          `json:"groups"`
+	ID                                  string                                 `json:"id"`
+	IdentityProviderMappers             []IdentityProviderMapperRepresentation `json:"identityProviderMappers"`
+	IdentityProviders                   []IdentityProviderRepresentation       `json:"identityProviders"`
+	InternationalizationEnabled         bool                                   `json:"internationalizationEnabled"`
+	KeycloakVersion                     string                                 `json:"keycloakVersion"`
+	LoginTheme                          string                                 `json:"loginTheme"`
+	LoginWithEmailAllowed               bool                                   `json:"loginWithEmailAllowed"`
+	MaxDeltaTimeSeconds                 int                                    `json:"maxDeltaTimeSeconds"`
+	MaxFailureWaitSeconds               int                                    `json:"maxFailureWaitSeconds"`
+	MinimumQuickLoginWaitSeconds        int                                    `json:"minimumQuickLoginWaitSeconds"`
+	NotBefore                           int                                    `json:"notBefore"`
+	OfflineSessionIdleTimeout           int                                    `json:"offlineSessionIdleTimeout"`
+	OfflineSessionMaxLifespan           int                                    `json:"offlineSessionMaxLifespan"`
+	OfflineSessionMaxLifespanEnabled    bool                                   `json:"offlineSessionMaxLifespanEnabled"`
+	OtpPolicyAlgorithm                  string                                 `json:"otpPolicyAlgorithm"`
+	OtpPolicyDigits                     int                                    `json:"otpPolicyDigits"`
+	OtpPolicyInitialCounter             int                                    `json:"otpPolicyInitialCounter"`
+	OtpPolicyLookAheadWindow            int                                    `json:"otpPolicyLookAheadWindow"`
+	OtpPolicyPeriod                     int                                    `json:"otpPolicyPeriod"`
+	OtpPolicyType                       string                                 `json:"otpPolicyType"`
+	OtpSupportedApplications            []string                               `json:"otpSupportedApplications"`
+	PasswordPolicy                      string                                 `json:"passwordPolicy"`
+	PermanentLockout                    bool                                   `json:"permanentLockout"`
+	ProtocolMappers                     []interface{}                          `json:"protocolMappers"`
+	QuickLoginCheckMilliSeconds         int64                                  `json:"quickLoginCheckMilliSeconds"`
+	Realm                               string                                 `json:"realm"`
+	RefreshTokenMaxReuse                int                                    `json:"refreshTokenMaxReuse"`
+	RegistrationAllowed                 bool                                   `json:"registrationAllowed"`
+	RegistrationEmailAsUsername         bool                                   `json:"registrationEmailAsUsername"`
+	RegistrationFlow                    string                                 `json:"registrationFlow"`
+	RememberMe                          bool                                   `json:"rememberMe"`
+	RequiredActions                     []interface{}                          `json:"requiredActions"`
+	ResetCredentialsFlow                string                                 `json:"resetCredentialsFlow"`
+	ResetPasswordAllowed                bool                                   `json:"resetPasswordAllowed"`
+	RevokeRefreshToken                  bool                                   `json:"revokeRefreshToken"`
+	Roles                               *RolesRepresentation                   `json:"roles"`
+	ScopeMappings                       []interface{}                          `json:"scopeMappings"`
+	SMTPServer                          map[string]string                      `json:"smtpServer"`
+	SslRequired                         string                                 `json:"sslRequired"`
+	SsoSessionIdleTimeout               int                                    `json:"ssoSessionIdleTimeout"`
+	SsoSessionIdleTimeoutRememberMe     int                                    `json:"ssoSessionIdleTimeoutRememberMe"`
+	SsoSessionMaxLifespan               int                                    `json:"ssoSessionMaxLifespan"`
+	SsoSessionMaxLifespanRememberMe     int                                    `json:"ssoSessionMaxLifespanRememberMe"`
+	SupportedLocales                    []string                               `json:"supportedLocales"`
+	UserFederationMappers               []UserFederationMapperRepresentation   `json:"userFederationMappers"`
+	UserFederationProviders             []UserFederationProviderRepresentation `json:"userFederationProviders"`
+	UserManagedAccessAllowed            bool                                   `json:"userManagedAccessAllowed"`
+	Users                               []interface{}                          `json:"users"`
+	VerifyEmail                         bool                                   `json:"verifyEmail"`
+	WaitIncrementSeconds                int                                    `json:"waitIncrementSeconds"`
 }
 
 // MultivaluedHashMap represents something
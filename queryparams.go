@@ -0,0 +1,90 @@
+package gocloak
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// GetQueryParams walks v's fields via reflection and encodes them into url.Values, honoring
+// `json:"name,omitempty"` tags. Pointer fields (*bool, *int, *string, ...) are only included
+// when non-nil, which lets callers distinguish "unset" from the zero value; plain fields are
+// included unless they are the zero value and tagged omitempty. []string fields add one
+// entry per element, matching how Keycloak binds these as repeated @QueryParam List<String>
+// parameters (e.g. type=LOGIN&type=LOGOUT). Values are left unescaped: the caller is expected
+// to feed the result to resty's SetQueryParamsFromValues, which does its own percent-encoding.
+func GetQueryParams(v interface{}) (url.Values, error) {
+	result := url.Values{}
+
+	val := reflect.ValueOf(v)
+	for val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return result, nil
+		}
+		val = val.Elem()
+	}
+	typ := val.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.Anonymous {
+			continue
+		}
+
+		name, omitempty := parseJSONTag(field.Tag.Get("json"))
+		if name == "" {
+			continue
+		}
+
+		fieldValue := val.Field(i)
+		if fieldValue.Kind() == reflect.Ptr {
+			if fieldValue.IsNil() {
+				continue
+			}
+			fieldValue = fieldValue.Elem()
+		} else if fieldValue.Kind() == reflect.Slice {
+			for j := 0; j < fieldValue.Len(); j++ {
+				result.Add(name, stringifyValue(fieldValue.Index(j)))
+			}
+			continue
+		} else if omitempty && fieldValue.IsZero() {
+			continue
+		}
+
+		result.Set(name, stringifyValue(fieldValue))
+	}
+
+	return result, nil
+}
+
+// parseJSONTag splits a `json:"name,omitempty"` tag into its name and omitempty flag
+func parseJSONTag(tag string) (name string, omitempty bool) {
+	if tag == "" || tag == "-" {
+		return "", false
+	}
+	parts := strings.Split(tag, ",")
+	name = parts[0]
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" {
+			omitempty = true
+		}
+	}
+	return name, omitempty
+}
+
+func stringifyValue(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	default:
+		return fmt.Sprintf("%v", v.Interface())
+	}
+}
@@ -0,0 +1,66 @@
+package gocloak
+
+import (
+	"net/url"
+	"testing"
+)
+
+func strPtr(s string) *string { return &s }
+func intPtr(i int) *int       { return &i }
+func boolPtr(b bool) *bool    { return &b }
+
+func TestGetQueryParams(t *testing.T) {
+	tests := []struct {
+		name string
+		in   interface{}
+		want url.Values
+	}{
+		{
+			name: "nil pointer fields are omitted",
+			in:   GetUsersParams{},
+			want: url.Values{},
+		},
+		{
+			name: "unescaped values are preserved for the caller to encode once",
+			in:   GetUsersParams{Search: strPtr("a b/c+d&e")},
+			want: url.Values{"search": {"a b/c+d&e"}},
+		},
+		{
+			name: "zero-valued pointer is still included, unlike a zero-valued plain field",
+			in:   GetUsersParams{First: intPtr(0)},
+			want: url.Values{"first": {"0"}},
+		},
+		{
+			name: "bool pointer",
+			in:   GetUsersParams{BriefRepresentation: boolPtr(true)},
+			want: url.Values{"briefRepresentation": {"true"}},
+		},
+		{
+			name: "field tagged json:\"-\" is skipped even when set",
+			in:   ExecuteActionsEmail{UserID: "ignored via json:\"-\""},
+			want: url.Values{},
+		},
+		{
+			name: "slice fields add one entry per element instead of comma-joining",
+			in:   GetEventsParams{Type: []string{"LOGIN", "LOGOUT"}},
+			want: url.Values{"type": {"LOGIN", "LOGOUT"}},
+		},
+		{
+			name: "empty slice is omitted",
+			in:   GetEventsParams{Type: []string{}},
+			want: url.Values{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetQueryParams(tt.in)
+			if err != nil {
+				t.Fatalf("GetQueryParams() error = %v", err)
+			}
+			if got.Encode() != tt.want.Encode() {
+				t.Errorf("GetQueryParams() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
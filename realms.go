@@ -0,0 +1,90 @@
+package gocloak
+
+import (
+	"context"
+	"strconv"
+)
+
+// IfResourceExists selects how PartialImport should handle a resource that already exists
+type IfResourceExists string
+
+// IfResourceExists strategies supported by the partial import endpoint
+const (
+	IfResourceExistsFail      IfResourceExists = "FAIL"
+	IfResourceExistsSkip      IfResourceExists = "SKIP"
+	IfResourceExistsOverwrite IfResourceExists = "OVERWRITE"
+)
+
+// RolesRepresentation splits roles into realm-level and per-client roles, as used by
+// RealmRepresentation.Roles and PartialImportRepresentation.Roles
+type RolesRepresentation struct {
+	Realm  []Role            `json:"realm,omitempty"`
+	Client map[string][]Role `json:"client,omitempty"`
+}
+
+// PartialImportRepresentation describes a subset of a realm to merge into an existing realm.
+// IfResourceExists controls how conflicts with already-present users, clients, roles, or
+// identity providers are resolved.
+type PartialImportRepresentation struct {
+	IfResourceExists  IfResourceExists                 `json:"ifResourceExists,omitempty"`
+	Users             []User                           `json:"users,omitempty"`
+	Clients           []Client                         `json:"clients,omitempty"`
+	Roles             *RolesRepresentation             `json:"roles,omitempty"`
+	IdentityProviders []IdentityProviderRepresentation `json:"identityProviders,omitempty"`
+}
+
+// PartialImportResult reports the outcome of importing a single resource
+type PartialImportResult struct {
+	Action       string `json:"action,omitempty"`
+	ResourceType string `json:"resourceType,omitempty"`
+	ResourceName string `json:"resourceName,omitempty"`
+	ID           string `json:"id,omitempty"`
+}
+
+// PartialImportResponse reports the outcome of a PartialImport call
+type PartialImportResponse struct {
+	Overwritten int                   `json:"overwritten,omitempty"`
+	Added       int                   `json:"added,omitempty"`
+	Skipped     int                   `json:"skipped,omitempty"`
+	Results     []PartialImportResult `json:"results,omitempty"`
+}
+
+// ImportRealm creates a new realm from a full RealmRepresentation, as produced by ExportRealm
+func (g *GoCloak) ImportRealm(ctx context.Context, token string, realm RealmRepresentation) error {
+	resp, err := g.getRequest(ctx, token).
+		SetBody(realm).
+		Post(g.getAdminURL("realms"))
+	return checkForError(resp, err, "could not import realm")
+}
+
+// ExportRealm returns a full or partial representation of a realm, suitable for feeding back
+// into ImportRealm. exportClients and exportGroupsAndRoles control whether those sections are
+// included in the export.
+func (g *GoCloak) ExportRealm(ctx context.Context, token, realm string, exportClients, exportGroupsAndRoles bool) (*RealmRepresentation, error) {
+	var result RealmRepresentation
+	resp, err := g.getRequest(ctx, token).
+		SetQueryParams(map[string]string{
+			"exportClients":        strconv.FormatBool(exportClients),
+			"exportGroupsAndRoles": strconv.FormatBool(exportGroupsAndRoles),
+		}).
+		SetResult(&result).
+		Post(g.getAdminRealmURL(realm, "partial-export"))
+	if err := checkForError(resp, err, "could not export realm"); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// PartialImport merges a subset of resources (users, clients, roles, identity providers) into
+// an existing realm, resolving conflicts per rep.IfResourceExists
+func (g *GoCloak) PartialImport(ctx context.Context, token, realm string, rep PartialImportRepresentation) (*PartialImportResponse, error) {
+	var result PartialImportResponse
+	resp, err := g.getRequest(ctx, token).
+		SetBody(rep).
+		SetResult(&result).
+		Post(g.getAdminRealmURL(realm, "partialImport"))
+	if err := checkForError(resp, err, "could not partially import realm"); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,193 @@
+package gocloak
+
+import "context"
+
+// UserStorageProviderType is the Component.ProviderType of an LDAP/Kerberos user federation provider
+const UserStorageProviderType = "org.keycloak.storage.UserStorageProvider"
+
+// LDAPStorageMapperType is the Component.ProviderType of a federation mapper attached to an LDAP provider
+const LDAPStorageMapperType = "org.keycloak.storage.ldap.mappers.LDAPStorageMapper"
+
+// LDAP storage mapper subtypes, used as a UserFederationMapperRepresentation's ProviderID
+const (
+	LDAPMapperUserAttribute = "user-attribute-ldap-mapper"
+	LDAPMapperGroup         = "group-ldap-mapper"
+	LDAPMapperRole          = "role-ldap-mapper"
+	LDAPMapperFullName      = "full-name-ldap-mapper"
+)
+
+// UserFederationSyncAction selects what TriggerUserFederationSync synchronizes
+type UserFederationSyncAction string
+
+// UserFederationSyncAction values accepted by the sync endpoint
+const (
+	SyncActionTriggerFullSync         UserFederationSyncAction = "triggerFullSync"
+	SyncActionTriggerChangedUsersSync UserFederationSyncAction = "triggerChangedUsersSync"
+)
+
+// UserFederationProviderRepresentation is a user federation provider (LDAP, Kerberos, ...),
+// stored by Keycloak as a realm-parented Component. ProviderID is "ldap" or "kerberos";
+// Config carries provider-specific settings (connectionUrl, bindDn, usersDn, ...) in the same
+// multivalued-map shape Keycloak uses for all component config.
+type UserFederationProviderRepresentation struct {
+	ID           string              `json:"id,omitempty"`
+	Name         string              `json:"name,omitempty"`
+	ProviderID   string              `json:"providerId,omitempty"`
+	ProviderType string              `json:"providerType,omitempty"`
+	ParentID     string              `json:"parentId,omitempty"`
+	Config       map[string][]string `json:"config,omitempty"`
+}
+
+// UserFederationMapperRepresentation is a mapper attached to a user federation provider,
+// stored by Keycloak as a Component parented to the provider's component ID. ProviderID
+// selects the mapper subtype (LDAPMapperUserAttribute, LDAPMapperGroup, LDAPMapperRole,
+// LDAPMapperFullName, ...).
+type UserFederationMapperRepresentation struct {
+	ID           string              `json:"id,omitempty"`
+	Name         string              `json:"name,omitempty"`
+	ProviderID   string              `json:"providerId,omitempty"`
+	ProviderType string              `json:"providerType,omitempty"`
+	ParentID     string              `json:"parentId,omitempty"`
+	Config       map[string][]string `json:"config,omitempty"`
+}
+
+// SyncResultRepresentation reports the outcome of a user federation sync
+type SyncResultRepresentation struct {
+	Added   int    `json:"added,omitempty"`
+	Updated int    `json:"updated,omitempty"`
+	Removed int    `json:"removed,omitempty"`
+	Failed  int    `json:"failed,omitempty"`
+	Ignored bool   `json:"ignored,omitempty"`
+	Status  string `json:"status,omitempty"`
+}
+
+// CreateUserFederationProvider creates an LDAP/Kerberos user federation provider in a realm
+func (g *GoCloak) CreateUserFederationProvider(ctx context.Context, token, realm string, provider UserFederationProviderRepresentation) (string, error) {
+	provider.ProviderType = UserStorageProviderType
+	resp, err := g.getRequest(ctx, token).
+		SetBody(provider).
+		Post(g.getAdminRealmURL(realm, "components"))
+	if err := checkForError(resp, err, "could not create user federation provider"); err != nil {
+		return "", err
+	}
+	return getIDFromLocationHeader(resp.Header().Get("Location")), nil
+}
+
+// GetUserFederationProvider returns a single user federation provider of a realm
+func (g *GoCloak) GetUserFederationProvider(ctx context.Context, token, realm, id string) (*UserFederationProviderRepresentation, error) {
+	var result UserFederationProviderRepresentation
+	resp, err := g.getRequest(ctx, token).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "components", id))
+	if err := checkForError(resp, err, "could not get user federation provider"); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetUserFederationProviders returns all user federation providers of a realm
+func (g *GoCloak) GetUserFederationProviders(ctx context.Context, token, realm string) ([]*UserFederationProviderRepresentation, error) {
+	var result []*UserFederationProviderRepresentation
+	resp, err := g.getRequest(ctx, token).
+		SetQueryParams(map[string]string{"type": UserStorageProviderType}).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "components"))
+	if err := checkForError(resp, err, "could not get user federation providers"); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateUserFederationProvider updates a user federation provider of a realm
+func (g *GoCloak) UpdateUserFederationProvider(ctx context.Context, token, realm string, provider UserFederationProviderRepresentation) error {
+	resp, err := g.getRequest(ctx, token).
+		SetBody(provider).
+		Put(g.getAdminRealmURL(realm, "components", provider.ID))
+	return checkForError(resp, err, "could not update user federation provider")
+}
+
+// DeleteUserFederationProvider deletes a user federation provider from a realm
+func (g *GoCloak) DeleteUserFederationProvider(ctx context.Context, token, realm, id string) error {
+	resp, err := g.getRequest(ctx, token).
+		Delete(g.getAdminRealmURL(realm, "components", id))
+	return checkForError(resp, err, "could not delete user federation provider")
+}
+
+// TriggerUserFederationSync synchronizes users from a federation provider into Keycloak
+func (g *GoCloak) TriggerUserFederationSync(ctx context.Context, token, realm, id string, action UserFederationSyncAction) (*SyncResultRepresentation, error) {
+	var result SyncResultRepresentation
+	resp, err := g.getRequest(ctx, token).
+		SetQueryParams(map[string]string{"action": string(action)}).
+		SetResult(&result).
+		Post(g.getAdminRealmURL(realm, "user-storage", id, "sync"))
+	if err := checkForError(resp, err, "could not trigger user federation sync"); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateUserFederationMapper creates a mapper attached to a user federation provider
+func (g *GoCloak) CreateUserFederationMapper(ctx context.Context, token, realm string, mapper UserFederationMapperRepresentation) (string, error) {
+	mapper.ProviderType = LDAPStorageMapperType
+	resp, err := g.getRequest(ctx, token).
+		SetBody(mapper).
+		Post(g.getAdminRealmURL(realm, "components"))
+	if err := checkForError(resp, err, "could not create user federation mapper"); err != nil {
+		return "", err
+	}
+	return getIDFromLocationHeader(resp.Header().Get("Location")), nil
+}
+
+// GetUserFederationMapper returns a single mapper of a user federation provider
+func (g *GoCloak) GetUserFederationMapper(ctx context.Context, token, realm, id string) (*UserFederationMapperRepresentation, error) {
+	var result UserFederationMapperRepresentation
+	resp, err := g.getRequest(ctx, token).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "components", id))
+	if err := checkForError(resp, err, "could not get user federation mapper"); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetUserFederationMappers returns all mappers attached to a user federation provider
+func (g *GoCloak) GetUserFederationMappers(ctx context.Context, token, realm, parentID string) ([]*UserFederationMapperRepresentation, error) {
+	var result []*UserFederationMapperRepresentation
+	resp, err := g.getRequest(ctx, token).
+		SetQueryParams(map[string]string{"parent": parentID, "type": LDAPStorageMapperType}).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "components"))
+	if err := checkForError(resp, err, "could not get user federation mappers"); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateUserFederationMapper updates a mapper of a user federation provider
+func (g *GoCloak) UpdateUserFederationMapper(ctx context.Context, token, realm string, mapper UserFederationMapperRepresentation) error {
+	resp, err := g.getRequest(ctx, token).
+		SetBody(mapper).
+		Put(g.getAdminRealmURL(realm, "components", mapper.ID))
+	return checkForError(resp, err, "could not update user federation mapper")
+}
+
+// DeleteUserFederationMapper deletes a mapper from a user federation provider
+func (g *GoCloak) DeleteUserFederationMapper(ctx context.Context, token, realm, id string) error {
+	resp, err := g.getRequest(ctx, token).
+		Delete(g.getAdminRealmURL(realm, "components", id))
+	return checkForError(resp, err, "could not delete user federation mapper")
+}
+
+// TriggerUserFederationMapperSync synchronizes a single mapper's data between Keycloak and
+// the federation provider. direction is "fedToKeycloak" or "keycloakToFed".
+func (g *GoCloak) TriggerUserFederationMapperSync(ctx context.Context, token, realm, parentID, mapperID, direction string) (*SyncResultRepresentation, error) {
+	var result SyncResultRepresentation
+	resp, err := g.getRequest(ctx, token).
+		SetQueryParams(map[string]string{"direction": direction}).
+		SetResult(&result).
+		Post(g.getAdminRealmURL(realm, "user-storage", parentID, "mappers", mapperID, "sync"))
+	if err := checkForError(resp, err, "could not trigger user federation mapper sync"); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}